@@ -1,6 +1,9 @@
 package lo
 
 import (
+	"context"
+	"errors"
+	"math/rand"
 	"sync"
 	"time"
 )
@@ -61,6 +64,205 @@ func NewDebounce(duration time.Duration, f ...func()) (func(), func()) {
 	}, d.cancel
 }
 
+type debounceCtx struct {
+	after     time.Duration
+	mu        *sync.Mutex
+	timer     *time.Timer
+	done      bool
+	callbacks []func(ctx context.Context)
+}
+
+func (d *debounceCtx) reset(ctx context.Context) *debounceCtx {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.done {
+		return d
+	}
+
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+
+	d.timer = time.AfterFunc(d.after, func() {
+		for _, f := range d.callbacks {
+			f(ctx)
+		}
+	})
+	return d
+}
+
+func (d *debounceCtx) cancel() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+
+	d.done = true
+}
+
+// NewDebounceContext behaves like NewDebounce, but the callbacks receive ctx, and the debounce is
+// cancelled automatically as soon as ctx is done, stopping any pending timer.
+func NewDebounceContext(ctx context.Context, duration time.Duration, f ...func(ctx context.Context)) (func(), func()) {
+	d := &debounceCtx{
+		after:     duration,
+		mu:        new(sync.Mutex),
+		timer:     nil,
+		done:      false,
+		callbacks: f,
+	}
+
+	go func() {
+		<-ctx.Done()
+		d.cancel()
+	}()
+
+	return func() {
+		d.reset(ctx)
+	}, d.cancel
+}
+
+// NewDebounceChannel behaves like NewDebounce, except that instead of invoking callbacks, each effective
+// fire sends the wall-clock time on the returned `fired` channel, making the debounce observable with a
+// plain `select` instead of callback bookkeeping. `fired` is buffered with size 1; a fire that arrives
+// while the previous one hasn't been drained yet is dropped.
+func NewDebounceChannel(duration time.Duration) (trigger func(), fired <-chan time.Time, cancel func()) {
+	ch := make(chan time.Time, 1)
+
+	trigger, cancel = NewDebounce(duration, func() {
+		select {
+		case ch <- time.Now():
+		default:
+		}
+	})
+
+	return trigger, ch, cancel
+}
+
+type debounceEvent struct {
+	timer *time.Timer
+	done  chan struct{}
+	ran   bool
+}
+
+type keyedDebounce[K comparable] struct {
+	after  time.Duration
+	mu     *sync.Mutex
+	events map[K]*debounceEvent
+}
+
+func (d *keyedDebounce[K]) trigger(key K, f func()) *debounceEvent {
+	d.mu.Lock()
+
+	if prev, ok := d.events[key]; ok {
+		if prev.timer.Stop() {
+			close(prev.done)
+		}
+	}
+
+	event := &debounceEvent{
+		done: make(chan struct{}),
+	}
+
+	event.timer = time.AfterFunc(d.after, func() {
+		d.mu.Lock()
+		current := d.events[key] == event
+		if current {
+			delete(d.events, key)
+			event.ran = true
+		}
+		d.mu.Unlock()
+
+		// time.Timer.Stop returns false once the timer's function has started, even if it hasn't
+		// run yet, so a superseded event can still reach this point: only invoke f if we're still
+		// the current event for this key.
+		if current {
+			f()
+		}
+		close(event.done)
+	})
+
+	d.events[key] = event
+	d.mu.Unlock()
+
+	return event
+}
+
+func (d *keyedDebounce[K]) cancel(key K) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if event, ok := d.events[key]; ok {
+		if event.timer.Stop() {
+			close(event.done)
+		}
+		delete(d.events, key)
+	}
+}
+
+// NewKeyedDebounce creates a debounced instance scoped per key: triggering a key starts (or restarts) a
+// timer for that key alone, and a later trigger for the same key cancels the function scheduled by an
+// earlier one so that only the newest call for that key ever runs. `cancel` stops the pending call for a
+// given key, if any. `triggerAndWait` behaves like `trigger` but blocks until the scheduled call for `key`
+// either runs or is superseded, reporting whether `f` was the one that actually executed.
+func NewKeyedDebounce[K comparable](duration time.Duration) (trigger func(key K, f func()), triggerAndWait func(key K, f func()) (ran bool), cancel func(key K)) {
+	d := &keyedDebounce[K]{
+		after:  duration,
+		mu:     new(sync.Mutex),
+		events: map[K]*debounceEvent{},
+	}
+
+	trigger = func(key K, f func()) {
+		d.trigger(key, f)
+	}
+
+	triggerAndWait = func(key K, f func()) (ran bool) {
+		event := d.trigger(key, f)
+		<-event.done
+
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		return event.ran
+	}
+
+	return trigger, triggerAndWait, d.cancel
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+func (e *retryableError) Unwrap() error { return e.err }
+
+// Permanent wraps err so that AttemptIf and AttemptWithBackoffIf stop retrying and return immediately,
+// unwrapping back to err. The other Attempt functions treat it like any other error. A nil err returns nil.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// Retryable wraps err to explicitly mark it as transient, overriding a shouldRetry predicate that would
+// otherwise treat it as permanent. A nil err returns nil.
+func Retryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err: err}
+}
+
 // Attempt invokes a function N times until it returns valid output. Returning either the caught error or nil. When first argument is less than `1`, the function runs until a successful response is returned.
 // Play: https://go.dev/play/p/3ggJZ2ZKcMj
 func Attempt(maxIteration int, f func(index int) error) (int, error) {
@@ -77,6 +279,27 @@ func Attempt(maxIteration int, f func(index int) error) (int, error) {
 	return maxIteration, err
 }
 
+// AttemptWithContext behaves like Attempt, but aborts as soon as ctx is done, returning ctx.Err() joined
+// with the last error produced by f.
+func AttemptWithContext(ctx context.Context, maxIteration int, f func(ctx context.Context, index int) error) (int, error) {
+	var err error
+
+	for i := 0; maxIteration <= 0 || i < maxIteration; i++ {
+		select {
+		case <-ctx.Done():
+			return i, errors.Join(ctx.Err(), err)
+		default:
+		}
+
+		err = f(ctx, i)
+		if err == nil {
+			return i + 1, nil
+		}
+	}
+
+	return maxIteration, err
+}
+
 // AttemptWithDelay invokes a function N times until it returns valid output,
 // with a pause between each call. Returning either the caught error or nil.
 // When first argument is less than `1`, the function runs until a successful
@@ -101,6 +324,38 @@ func AttemptWithDelay(maxIteration int, delay time.Duration, f func(index int, d
 	return maxIteration, time.Since(start), err
 }
 
+// AttemptWithDelayContext behaves like AttemptWithDelay, but aborts as soon as ctx is done — whether
+// waiting to call f or sleeping between attempts — returning ctx.Err() joined with the last error
+// produced by f.
+func AttemptWithDelayContext(ctx context.Context, maxIteration int, delay time.Duration, f func(ctx context.Context, index int, duration time.Duration) error) (int, time.Duration, error) {
+	var err error
+
+	start := time.Now()
+
+	for i := 0; maxIteration <= 0 || i < maxIteration; i++ {
+		select {
+		case <-ctx.Done():
+			return i, time.Since(start), errors.Join(ctx.Err(), err)
+		default:
+		}
+
+		err = f(ctx, i, time.Since(start))
+		if err == nil {
+			return i + 1, time.Since(start), nil
+		}
+
+		if maxIteration <= 0 || i+1 < maxIteration {
+			select {
+			case <-ctx.Done():
+				return i + 1, time.Since(start), errors.Join(ctx.Err(), err)
+			case <-time.After(delay):
+			}
+		}
+	}
+
+	return maxIteration, time.Since(start), err
+}
+
 // AttemptWhile invokes a function N times until it returns valid output.
 // Returning either the caught error or nil, and along with a bool value to identify
 // whether it needs invoke function continuously. It will terminate the invoke
@@ -154,4 +409,333 @@ func AttemptWhileWithDelay(maxIteration int, delay time.Duration, f func(int, ti
 	return maxIteration, time.Since(start), err
 }
 
-// throttle ?
+// AttemptIf invokes a function N times until it returns valid output, like Attempt, but consults
+// shouldRetry(err, index) after every failed attempt to decide whether another attempt should be made. A
+// nil shouldRetry retries on any error, matching Attempt's behavior. Regardless of shouldRetry, an error
+// wrapped with Permanent always stops the loop immediately, unwrapped back to the original error, and an
+// error wrapped with Retryable always continues without consulting shouldRetry.
+func AttemptIf(maxIteration int, shouldRetry func(err error, index int) bool, f func(index int) error) (int, error) {
+	var err error
+
+	for i := 0; maxIteration <= 0 || i < maxIteration; i++ {
+		err = f(i)
+		if err == nil {
+			return i + 1, nil
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			return i + 1, permanent.err
+		}
+
+		var retryable *retryableError
+		if shouldRetry != nil && !errors.As(err, &retryable) && !shouldRetry(err, i) {
+			return i + 1, err
+		}
+	}
+
+	return maxIteration, err
+}
+
+// BackoffStrategy computes the delay to apply before the (i+1)-th attempt, given the initial and max
+// delay configured on a BackoffConfig.
+type BackoffStrategy func(i int, initial, max time.Duration) time.Duration
+
+// BackoffExponential doubles the delay on every attempt: min(max, initial * 2^i).
+func BackoffExponential(i int, initial, max time.Duration) time.Duration {
+	delay := initial * (time.Duration(1) << uint(i))
+	if delay <= 0 || delay > max {
+		return max
+	}
+	return delay
+}
+
+// BackoffLinear grows the delay by `initial` on every attempt: min(max, initial * (i+1)).
+func BackoffLinear(i int, initial, max time.Duration) time.Duration {
+	delay := initial * time.Duration(i+1)
+	if delay <= 0 || delay > max {
+		return max
+	}
+	return delay
+}
+
+// BackoffConstant always waits `initial` between attempts, ignoring `max` unless it is smaller.
+func BackoffConstant(i int, initial, max time.Duration) time.Duration {
+	if initial > max {
+		return max
+	}
+	return initial
+}
+
+// BackoffFibonacci grows the delay following the Fibonacci sequence, scaled by `initial`: min(max, initial * fib(i+1)).
+func BackoffFibonacci(i int, initial, max time.Duration) time.Duration {
+	a, b := time.Duration(1), time.Duration(1)
+	for n := 0; n < i; n++ {
+		a, b = b, a+b
+	}
+
+	delay := initial * a
+	if delay <= 0 || delay > max {
+		return max
+	}
+	return delay
+}
+
+// BackoffConfig configures AttemptWithBackoff and its variants.
+type BackoffConfig struct {
+	// Strategy computes the un-jittered delay for a given attempt index. Defaults to BackoffExponential.
+	Strategy BackoffStrategy
+	// MaxElapsed caps the total time elapsed since the first attempt; it is checked before each attempt,
+	// and once exceeded no further attempt is made and the last error is returned. Zero means unbounded.
+	MaxElapsed time.Duration
+	// Source supplies the randomness used for full jitter. Defaults to a source seeded from the current
+	// time, but tests can inject a deterministic one.
+	Source rand.Source
+}
+
+// BackoffOption configures a BackoffConfig.
+type BackoffOption func(*BackoffConfig)
+
+// WithBackoffStrategy overrides the delay growth strategy. Defaults to BackoffExponential.
+func WithBackoffStrategy(strategy BackoffStrategy) BackoffOption {
+	return func(c *BackoffConfig) {
+		c.Strategy = strategy
+	}
+}
+
+// WithMaxElapsed caps the total time elapsed since the first attempt, after which no further attempt is made.
+func WithMaxElapsed(d time.Duration) BackoffOption {
+	return func(c *BackoffConfig) {
+		c.MaxElapsed = d
+	}
+}
+
+// WithRandSource supplies a custom source of randomness for jitter, useful for deterministic tests.
+func WithRandSource(source rand.Source) BackoffOption {
+	return func(c *BackoffConfig) {
+		c.Source = source
+	}
+}
+
+func newBackoffConfig(opts ...BackoffOption) *BackoffConfig {
+	c := &BackoffConfig{
+		Strategy: BackoffExponential,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.Source == nil {
+		c.Source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	return c
+}
+
+// AttemptWithBackoff invokes a function N times until it returns valid output, sleeping an exponentially
+// growing, fully-jittered delay between attempts: the computed delay is `min(max, initial * 2^i)` and the
+// actual sleep is a random duration in `[0, computed]`. Returning either the caught error or nil, along
+// with the total elapsed time. When first argument is less than `1`, the function runs until a successful
+// response is returned. Pass BackoffOption values to customize the growth strategy, cap the total elapsed
+// time, or supply a deterministic random source.
+func AttemptWithBackoff(maxIteration int, initial, max time.Duration, f func(index int, elapsed time.Duration) error, opts ...BackoffOption) (int, time.Duration, error) {
+	config := newBackoffConfig(opts...)
+	rnd := rand.New(config.Source)
+
+	var err error
+	start := time.Now()
+
+	i := 0
+	for ; maxIteration <= 0 || i < maxIteration; i++ {
+		elapsed := time.Since(start)
+		if config.MaxElapsed > 0 && elapsed > config.MaxElapsed {
+			break
+		}
+
+		err = f(i, elapsed)
+		if err == nil {
+			return i + 1, time.Since(start), nil
+		}
+
+		if maxIteration <= 0 || i+1 < maxIteration {
+			delay := config.Strategy(i, initial, max)
+			if delay > 0 {
+				time.Sleep(time.Duration(rnd.Int63n(int64(delay) + 1)))
+			}
+		}
+	}
+
+	return i, time.Since(start), err
+}
+
+// AttemptWhileWithBackoff behaves like AttemptWithBackoff, but also accepts a bool return value from `f`
+// to signal whether retries should continue; it terminates immediately when that value is false.
+func AttemptWhileWithBackoff(maxIteration int, initial, max time.Duration, f func(index int, elapsed time.Duration) (error, bool), opts ...BackoffOption) (int, time.Duration, error) {
+	config := newBackoffConfig(opts...)
+	rnd := rand.New(config.Source)
+
+	var err error
+	var shouldContinueInvoke bool
+	start := time.Now()
+
+	i := 0
+	for ; maxIteration <= 0 || i < maxIteration; i++ {
+		elapsed := time.Since(start)
+		if config.MaxElapsed > 0 && elapsed > config.MaxElapsed {
+			break
+		}
+
+		err, shouldContinueInvoke = f(i, elapsed)
+		if !shouldContinueInvoke {
+			return i + 1, time.Since(start), err
+		}
+		if err == nil {
+			return i + 1, time.Since(start), nil
+		}
+
+		if maxIteration <= 0 || i+1 < maxIteration {
+			delay := config.Strategy(i, initial, max)
+			if delay > 0 {
+				time.Sleep(time.Duration(rnd.Int63n(int64(delay) + 1)))
+			}
+		}
+	}
+
+	return i, time.Since(start), err
+}
+
+// AttemptWithBackoffIf combines AttemptWithBackoff and AttemptIf: it sleeps a jittered, growing delay
+// between attempts like AttemptWithBackoff, but consults shouldRetry(err, index) after every failed
+// attempt to decide whether to continue, and stops immediately on an error wrapped with Permanent. A nil
+// shouldRetry retries on any error, and an error wrapped with Retryable always continues without
+// consulting shouldRetry.
+func AttemptWithBackoffIf(maxIteration int, initial, max time.Duration, shouldRetry func(err error, index int) bool, f func(index int, elapsed time.Duration) error, opts ...BackoffOption) (int, time.Duration, error) {
+	config := newBackoffConfig(opts...)
+	rnd := rand.New(config.Source)
+
+	var err error
+	start := time.Now()
+
+	i := 0
+	for ; maxIteration <= 0 || i < maxIteration; i++ {
+		elapsed := time.Since(start)
+		if config.MaxElapsed > 0 && elapsed > config.MaxElapsed {
+			break
+		}
+
+		err = f(i, elapsed)
+		if err == nil {
+			return i + 1, time.Since(start), nil
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			return i + 1, time.Since(start), permanent.err
+		}
+
+		var retryable *retryableError
+		if shouldRetry != nil && !errors.As(err, &retryable) && !shouldRetry(err, i) {
+			return i + 1, time.Since(start), err
+		}
+
+		if maxIteration <= 0 || i+1 < maxIteration {
+			delay := config.Strategy(i, initial, max)
+			if delay > 0 {
+				time.Sleep(time.Duration(rnd.Int63n(int64(delay) + 1)))
+			}
+		}
+	}
+
+	return i, time.Since(start), err
+}
+
+type throttle struct {
+	after time.Duration
+	mu    *sync.Mutex
+	last  time.Time
+	set   bool
+}
+
+func (t *throttle) call(f ...func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if t.set && now.Sub(t.last) < t.after {
+		return
+	}
+
+	t.last = now
+	t.set = true
+
+	for _, fn := range f {
+		fn()
+	}
+}
+
+func (t *throttle) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.set = false
+}
+
+// NewThrottle creates a throttled instance that invokes the given functions at most once per `interval`,
+// on the leading edge. The first call runs the functions immediately; any call made before `interval` has
+// elapsed since the last effective call is dropped.
+func NewThrottle(interval time.Duration, f ...func()) (call func(), reset func()) {
+	t := &throttle{
+		after: interval,
+		mu:    new(sync.Mutex),
+	}
+
+	return func() {
+		t.call(f...)
+	}, t.reset
+}
+
+type throttleBy[T comparable] struct {
+	after time.Duration
+	mu    *sync.Mutex
+	last  map[T]time.Time
+}
+
+func (t *throttleBy[T]) call(key T, f ...func(key T)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if last, ok := t.last[key]; ok && now.Sub(last) < t.after {
+		return
+	}
+
+	t.last[key] = now
+
+	for _, fn := range f {
+		fn(key)
+	}
+}
+
+func (t *throttleBy[T]) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.last = map[T]time.Time{}
+}
+
+// NewThrottleBy creates a keyed throttled instance that invokes the given functions at most once per
+// `interval` for each distinct key, on the leading edge. The first call for a key runs the functions
+// immediately; any call made for that key before `interval` has elapsed since its last effective call is
+// dropped. `reset` clears the throttle state for all keys.
+func NewThrottleBy[T comparable](interval time.Duration, f ...func(key T)) (call func(key T), reset func()) {
+	t := &throttleBy[T]{
+		after: interval,
+		mu:    new(sync.Mutex),
+		last:  map[T]time.Time{},
+	}
+
+	return func(key T) {
+		t.call(key, f...)
+	}, t.reset
+}
@@ -0,0 +1,372 @@
+package lo
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewKeyedDebounceSupersession(t *testing.T) {
+	// The debounce window is kept long relative to how fast the loop below can re-trigger, so every
+	// trigger lands well inside the previous one's window and only the very last f should ever run.
+	trigger, _, cancel := NewKeyedDebounce[string](100 * time.Millisecond)
+	defer cancel("k")
+
+	var calls int64
+	const n = 200_000
+	for i := 0; i < n; i++ {
+		trigger("k", func() {
+			atomic.AddInt64(&calls, 1)
+		})
+	}
+
+	// give the last scheduled timer a chance to fire.
+	time.Sleep(200 * time.Millisecond)
+
+	if got := atomic.LoadInt64(&calls); got != 1 {
+		t.Fatalf("expected exactly 1 call to have run, got %d", got)
+	}
+}
+
+func TestAttemptWithContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls int
+	n, err := AttemptWithContext(ctx, 100, func(ctx context.Context, index int) error {
+		calls++
+		if calls == 3 {
+			cancel()
+		}
+		return errors.New("fail")
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error to wrap context.Canceled, got %v", err)
+	}
+	if n >= 100 {
+		t.Fatalf("expected to return before maxIteration, got %d", n)
+	}
+	if calls > 4 {
+		t.Fatalf("expected f to stop being called shortly after cancellation, got %d calls", calls)
+	}
+}
+
+func TestNewDebounceContextCancelStopsPendingTimer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls int64
+	trigger, _ := NewDebounceContext(ctx, 50*time.Millisecond, func(ctx context.Context) {
+		atomic.AddInt64(&calls, 1)
+	})
+
+	trigger()
+	cancel()
+
+	// give the pending timer a chance to fire if cancellation failed to stop it.
+	time.Sleep(100 * time.Millisecond)
+
+	if got := atomic.LoadInt64(&calls); got != 0 {
+		t.Fatalf("expected the pending timer to be stopped by ctx cancellation, got %d calls", got)
+	}
+}
+
+func TestNewDebounceChannelDeliversFire(t *testing.T) {
+	trigger, fired, cancel := NewDebounceChannel(10 * time.Millisecond)
+	defer cancel()
+
+	trigger()
+
+	select {
+	case <-fired:
+	case <-time.After(time.Second):
+		t.Fatal("expected a fire to be delivered on fired")
+	}
+}
+
+func TestNewDebounceChannelDropsUndrainedFire(t *testing.T) {
+	trigger, fired, cancel := NewDebounceChannel(10 * time.Millisecond)
+	defer cancel()
+
+	trigger()
+	time.Sleep(50 * time.Millisecond)
+
+	trigger()
+	time.Sleep(50 * time.Millisecond)
+
+	select {
+	case <-fired:
+	default:
+		t.Fatal("expected at least one fire to have been delivered")
+	}
+
+	select {
+	case v := <-fired:
+		t.Fatalf("expected the second fire to have been dropped since fired wasn't drained in between, got %v", v)
+	default:
+	}
+}
+
+func TestNewThrottleLeadingEdge(t *testing.T) {
+	var calls int64
+	call, _ := NewThrottle(50*time.Millisecond, func() {
+		atomic.AddInt64(&calls, 1)
+	})
+
+	call()
+	if got := atomic.LoadInt64(&calls); got != 1 {
+		t.Fatalf("expected the first call to fire immediately, got %d calls", got)
+	}
+
+	call()
+	if got := atomic.LoadInt64(&calls); got != 1 {
+		t.Fatalf("expected a call inside the interval to be dropped, got %d calls", got)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	call()
+	if got := atomic.LoadInt64(&calls); got != 2 {
+		t.Fatalf("expected a call after the interval to fire again, got %d calls", got)
+	}
+}
+
+func TestNewThrottleByLeadingEdge(t *testing.T) {
+	var calls int64
+	call, _ := NewThrottleBy(50*time.Millisecond, func(key string) {
+		atomic.AddInt64(&calls, 1)
+	})
+
+	call("k")
+	if got := atomic.LoadInt64(&calls); got != 1 {
+		t.Fatalf("expected the first call for a key to fire immediately, got %d calls", got)
+	}
+
+	call("k")
+	if got := atomic.LoadInt64(&calls); got != 1 {
+		t.Fatalf("expected a call for the same key inside the interval to be dropped, got %d calls", got)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	call("k")
+	if got := atomic.LoadInt64(&calls); got != 2 {
+		t.Fatalf("expected a call for the same key after the interval to fire again, got %d calls", got)
+	}
+}
+
+func TestBackoffLinear(t *testing.T) {
+	initial, max := 10*time.Millisecond, 100*time.Millisecond
+
+	if got := BackoffLinear(0, initial, max); got != 10*time.Millisecond {
+		t.Fatalf("expected 10ms, got %v", got)
+	}
+	if got := BackoffLinear(2, initial, max); got != 30*time.Millisecond {
+		t.Fatalf("expected 30ms, got %v", got)
+	}
+	if got := BackoffLinear(20, initial, max); got != max {
+		t.Fatalf("expected delay to be clamped to max %v, got %v", max, got)
+	}
+}
+
+func TestBackoffConstant(t *testing.T) {
+	if got := BackoffConstant(5, 10*time.Millisecond, 100*time.Millisecond); got != 10*time.Millisecond {
+		t.Fatalf("expected the constant delay regardless of i, got %v", got)
+	}
+	if got := BackoffConstant(0, 100*time.Millisecond, 10*time.Millisecond); got != 10*time.Millisecond {
+		t.Fatalf("expected delay to be clamped to max when initial exceeds it, got %v", got)
+	}
+}
+
+func TestBackoffFibonacci(t *testing.T) {
+	initial, max := 10*time.Millisecond, 1000*time.Millisecond
+
+	if got := BackoffFibonacci(0, initial, max); got != 10*time.Millisecond {
+		t.Fatalf("expected fib(1)*initial = 10ms, got %v", got)
+	}
+	if got := BackoffFibonacci(1, initial, max); got != 10*time.Millisecond {
+		t.Fatalf("expected fib(2)*initial = 10ms, got %v", got)
+	}
+	if got := BackoffFibonacci(4, initial, max); got != 50*time.Millisecond {
+		t.Fatalf("expected fib(5)*initial = 50ms, got %v", got)
+	}
+	if got := BackoffFibonacci(20, initial, max); got != max {
+		t.Fatalf("expected delay to be clamped to max %v, got %v", max, got)
+	}
+}
+
+func TestWithBackoffStrategySwapsStrategy(t *testing.T) {
+	var used []int
+
+	_, _, _ = AttemptWithBackoff(3, time.Millisecond, time.Millisecond, func(index int, elapsed time.Duration) error {
+		return errors.New("fail")
+	}, WithBackoffStrategy(func(i int, initial, max time.Duration) time.Duration {
+		used = append(used, i)
+		return 0
+	}))
+
+	if len(used) != 2 {
+		t.Fatalf("expected the custom strategy to be consulted after each of the first 2 of 3 attempts, got %d calls: %v", len(used), used)
+	}
+}
+
+func TestAttemptWithBackoffJitterWithinBounds(t *testing.T) {
+	const initial, max = 100 * time.Millisecond, 100 * time.Millisecond
+	const tolerance = 50 * time.Millisecond
+
+	var elapsed []time.Duration
+	_, _, _ = AttemptWithBackoff(4, initial, max, func(index int, e time.Duration) error {
+		elapsed = append(elapsed, e)
+		return errors.New("fail")
+	}, WithRandSource(rand.NewSource(1)))
+
+	for i := 1; i < len(elapsed); i++ {
+		delay := elapsed[i] - elapsed[i-1]
+		if delay < 0 || delay > max+tolerance {
+			t.Fatalf("expected jitter to stay within [0, %v], got %v between attempts %d and %d", max, delay, i-1, i)
+		}
+	}
+}
+
+func TestAttemptWithBackoffMaxElapsedAttemptCount(t *testing.T) {
+	var calls int
+	n, _, err := AttemptWithBackoff(0, time.Millisecond, 2*time.Millisecond, func(index int, elapsed time.Duration) error {
+		calls++
+		return errors.New("fail")
+	}, WithMaxElapsed(5*time.Millisecond))
+
+	if err == nil {
+		t.Fatal("expected a non-nil error")
+	}
+	if calls == 0 {
+		t.Fatal("expected at least one call to f")
+	}
+	if n != calls {
+		t.Fatalf("expected returned attempt count %d to match actual calls %d", n, calls)
+	}
+}
+
+func TestAttemptWithBackoffIfMaxElapsedAttemptCount(t *testing.T) {
+	var calls int
+	n, _, err := AttemptWithBackoffIf(0, time.Millisecond, 2*time.Millisecond, nil, func(index int, elapsed time.Duration) error {
+		calls++
+		return errors.New("fail")
+	}, WithMaxElapsed(5*time.Millisecond))
+
+	if err == nil {
+		t.Fatal("expected a non-nil error")
+	}
+	if calls == 0 {
+		t.Fatal("expected at least one call to f")
+	}
+	if n != calls {
+		t.Fatalf("expected returned attempt count %d to match actual calls %d", n, calls)
+	}
+}
+
+func TestAttemptIfPermanentShortCircuits(t *testing.T) {
+	var calls int
+	n, err := AttemptIf(5, func(err error, index int) bool {
+		return true
+	}, func(index int) error {
+		calls++
+		return Permanent(errors.New("boom"))
+	})
+
+	if calls != 1 {
+		t.Fatalf("expected Permanent to stop the loop after 1 call, got %d calls", calls)
+	}
+	if n != 1 {
+		t.Fatalf("expected attempt count 1, got %d", n)
+	}
+	if err == nil || err.Error() != "boom" {
+		t.Fatalf("expected the error to be unwrapped back to the original, got %v", err)
+	}
+}
+
+func TestAttemptIfNilShouldRetryRetriesOnAnyError(t *testing.T) {
+	var calls int
+	n, err := AttemptIf(3, nil, func(index int) error {
+		calls++
+		if calls < 3 {
+			return errors.New("transient")
+		}
+		return nil
+	})
+
+	if calls != 3 {
+		t.Fatalf("expected a nil shouldRetry to retry on every error, got %d calls", calls)
+	}
+	if n != 3 {
+		t.Fatalf("expected attempt count 3, got %d", n)
+	}
+	if err != nil {
+		t.Fatalf("expected a nil error after eventual success, got %v", err)
+	}
+}
+
+func TestAttemptWithBackoffIfPermanentShortCircuits(t *testing.T) {
+	var calls int
+	n, _, err := AttemptWithBackoffIf(5, time.Millisecond, time.Millisecond, func(err error, index int) bool {
+		return true
+	}, func(index int, elapsed time.Duration) error {
+		calls++
+		return Permanent(errors.New("boom"))
+	})
+
+	if calls != 1 {
+		t.Fatalf("expected Permanent to stop the loop after 1 call, got %d calls", calls)
+	}
+	if n != 1 {
+		t.Fatalf("expected attempt count 1, got %d", n)
+	}
+	if err == nil || err.Error() != "boom" {
+		t.Fatalf("expected the error to be unwrapped back to the original, got %v", err)
+	}
+}
+
+func TestAttemptWithBackoffIfNilShouldRetryRetriesOnAnyError(t *testing.T) {
+	var calls int
+	n, _, err := AttemptWithBackoffIf(3, time.Millisecond, time.Millisecond, nil, func(index int, elapsed time.Duration) error {
+		calls++
+		if calls < 3 {
+			return errors.New("transient")
+		}
+		return nil
+	})
+
+	if calls != 3 {
+		t.Fatalf("expected a nil shouldRetry to retry on every error, got %d calls", calls)
+	}
+	if n != 3 {
+		t.Fatalf("expected attempt count 3, got %d", n)
+	}
+	if err != nil {
+		t.Fatalf("expected a nil error after eventual success, got %v", err)
+	}
+}
+
+func TestAttemptIfRetryableOverridesShouldRetry(t *testing.T) {
+	var calls int
+	n, err := AttemptIf(3, func(err error, index int) bool {
+		return false
+	}, func(index int) error {
+		calls++
+		if calls < 3 {
+			return Retryable(errors.New("transient"))
+		}
+		return errors.New("final")
+	})
+
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+	if n != 3 {
+		t.Fatalf("expected attempt count 3, got %d", n)
+	}
+	if err == nil || err.Error() != "final" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}